@@ -18,11 +18,16 @@ import (
 	"errors"
 	"fmt"
 
+	"time"
+
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_hcm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds/internal"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
@@ -54,29 +59,122 @@ type EnvoySnapshot struct {
 	Listeners cache.Resources
 	// hiddenDeprecatedListeners are items in the EDS V2 response payload.
 	hiddenDeprecatedListeners cache.Resources
+
+	// Secrets are items in the SDS response payload.
+	Secrets cache.Resources
+
+	// hiddenDeprecatedSecrets are items in the SDS V2 response payload.
+	hiddenDeprecatedSecrets cache.Resources
+
+	// Runtimes are items in the RTDS response payload. There is no deprecated V2 counterpart:
+	// RTDS is only served over the V3 transport.
+	Runtimes cache.Resources
+
+	// ScopedRoutes are items in the SRDS response payload. There is no deprecated V2 counterpart:
+	// SRDS is only served over the V3 transport.
+	ScopedRoutes cache.Resources
+
+	// ExtensionConfigs are items in the ECDS response payload. There is no deprecated V2
+	// counterpart: ECDS is only served over the V3 transport.
+	ExtensionConfigs cache.Resources
+
+	// EndpointsTTLs holds the optional per-resource TTL for each entry in Endpoints, keyed by resource name.
+	// Resources with no entry here never expire.
+	EndpointsTTLs map[string]time.Duration
+
+	// ClustersTTLs holds the optional per-resource TTL for each entry in Clusters, keyed by resource name.
+	ClustersTTLs map[string]time.Duration
+
+	// RoutesTTLs holds the optional per-resource TTL for each entry in Routes, keyed by resource name.
+	RoutesTTLs map[string]time.Duration
+
+	// ListenersTTLs holds the optional per-resource TTL for each entry in Listeners, keyed by resource name.
+	ListenersTTLs map[string]time.Duration
+
+	// endpointCache, when set via UseLinearEndpointCache, replaces Endpoints as the source of
+	// truth for EDS reads so endpoint updates can be pushed by per-resource version instead of
+	// forcing a whole-snapshot rebuild.
+	endpointCache *LinearEndpointCache
 }
 
 var _ cache.Snapshot = &EnvoySnapshot{}
 
-// NewSnapshot creates a snapshot from response types and a version.
+// SnapshotOption configures optional behavior of NewSnapshot.
+type SnapshotOption func(*snapshotOptions)
+
+type snapshotOptions struct {
+	v2Downgrade bool
+}
+
+func defaultSnapshotOptions() *snapshotOptions {
+	return &snapshotOptions{v2Downgrade: true}
+}
+
+// WithV2Downgrade explicitly enables or disables building the deprecated V2 resource maps
+// alongside the V3 ones. It is enabled by default, since Envoy <1.18 can only be served via the
+// V2 type URLs.
+func WithV2Downgrade(enabled bool) SnapshotOption {
+	return func(o *snapshotOptions) {
+		o.v2Downgrade = enabled
+	}
+}
+
+// WithoutV2Downgrade skips building the deprecated V2 resource maps entirely. Operators running
+// only Envoy >=1.18 can use this to avoid the proto.Clone and type-conversion cost of the
+// downgrade path on every snapshot.
+func WithoutV2Downgrade() SnapshotOption {
+	return WithV2Downgrade(false)
+}
+
+// UseLinearEndpointCache switches the snapshot's EDS read path over to the given
+// LinearEndpointCache: GetResources(EndpointTypeV3) will materialize its current state on every
+// call instead of returning the (possibly stale) Endpoints field.
+func (s *EnvoySnapshot) UseLinearEndpointCache(c *LinearEndpointCache) {
+	s.endpointCache = c
+}
+
+// NewSnapshot creates a snapshot from response types and a version. By default, deprecated V2
+// counterparts of the endpoints, clusters, routes, listeners and secrets are also built (runtimes,
+// scoped routes and extension configs have none); pass WithoutV2Downgrade() to skip that work for
+// deployments that only ever serve Envoy >=1.18.
 func NewSnapshot(
 	version string,
 	endpoints []cache.Resource,
 	clusters []cache.Resource,
 	routes []cache.Resource,
 	listeners []cache.Resource,
+	secrets []cache.Resource,
+	runtimes []cache.Resource,
+	scopedRoutes []cache.Resource,
+	extensionConfigs []cache.Resource,
+	opts ...SnapshotOption,
 ) *EnvoySnapshot {
+	options := defaultSnapshotOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	// TODO: Copy resources
-	return &EnvoySnapshot{
-		Endpoints:                 cache.NewResources(version, endpoints),
-		hiddenDeprecatedEndpoints: cache.NewResources(version, nil),
-		Clusters:                  cache.NewResources(version, clusters),
-		hiddenDeprecatedClusters:  downgradeCacheResourceList(version, clusters),
-		Routes:                    cache.NewResources(version, routes),
-		hiddenDeprecatedRoutes:    cache.NewResources(version, nil),
-		Listeners:                 cache.NewResources(version, listeners),
-		hiddenDeprecatedListeners: downgradeCacheResourceList(version, clusters),
+	snap := &EnvoySnapshot{
+		Endpoints:        cache.NewResources(version, endpoints),
+		Clusters:         cache.NewResources(version, clusters),
+		Routes:           cache.NewResources(version, routes),
+		Listeners:        cache.NewResources(version, listeners),
+		Secrets:          cache.NewResources(version, secrets),
+		Runtimes:         cache.NewResources(version, runtimes),
+		ScopedRoutes:     cache.NewResources(version, scopedRoutes),
+		ExtensionConfigs: cache.NewResources(version, extensionConfigs),
 	}
+
+	if options.v2Downgrade {
+		snap.hiddenDeprecatedEndpoints = downgradeCacheResourceList(version, endpoints)
+		snap.hiddenDeprecatedClusters = downgradeCacheResourceList(version, clusters)
+		snap.hiddenDeprecatedRoutes = downgradeCacheResourceList(version, routes)
+		snap.hiddenDeprecatedListeners = downgradeCacheResourceList(version, listeners)
+		snap.hiddenDeprecatedSecrets = downgradeCacheResourceList(version, secrets)
+	}
+
+	return snap
 }
 
 func NewSnapshotFromResources(
@@ -84,18 +182,105 @@ func NewSnapshotFromResources(
 	clusters cache.Resources,
 	routes cache.Resources,
 	listeners cache.Resources,
+	secrets cache.Resources,
+	runtimes cache.Resources,
+	scopedRoutes cache.Resources,
+	extensionConfigs cache.Resources,
+	opts ...SnapshotOption,
 ) cache.Snapshot {
+	options := defaultSnapshotOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	// TODO: Copy resources and downgrade, maybe maintain hash to not do it too many times
-	return &EnvoySnapshot{
-		Endpoints:                 endpoints,
-		Clusters:                  clusters,
-		hiddenDeprecatedClusters:  downgradeCacheResources(clusters),
-		Routes:                    routes,
-		Listeners:                 listeners,
-		hiddenDeprecatedListeners: downgradeCacheResources(listeners),
+	snap := &EnvoySnapshot{
+		Endpoints:        endpoints,
+		Clusters:         clusters,
+		Routes:           routes,
+		Listeners:        listeners,
+		Secrets:          secrets,
+		Runtimes:         runtimes,
+		ScopedRoutes:     scopedRoutes,
+		ExtensionConfigs: extensionConfigs,
+	}
+
+	if options.v2Downgrade {
+		snap.hiddenDeprecatedEndpoints = downgradeCacheResources(endpoints)
+		snap.hiddenDeprecatedClusters = downgradeCacheResources(clusters)
+		snap.hiddenDeprecatedRoutes = downgradeCacheResources(routes)
+		snap.hiddenDeprecatedListeners = downgradeCacheResources(listeners)
+		snap.hiddenDeprecatedSecrets = downgradeCacheResources(secrets)
 	}
+
+	return snap
+}
+
+// NewSnapshotWithTTLs creates a snapshot from response types and a version, allowing individual
+// resources to carry a TTL. The per-resource TTLs are carried on EnvoySnapshot (see
+// EndpointsTTLs etc.), surfaced via GetResourcesAndTTL, and used by HeartbeatsDue to compute which
+// resources are due for a ttl/2 heartbeat. Actually sending the resulting heartbeat
+// DiscoveryResponses is the responsibility of the xDS server loop that reads this snapshot, not of
+// this package.
+func NewSnapshotWithTTLs(
+	version string,
+	endpoints []cache.ResourceWithTTL,
+	clusters []cache.ResourceWithTTL,
+	routes []cache.ResourceWithTTL,
+	listeners []cache.ResourceWithTTL,
+	opts ...SnapshotOption,
+) *EnvoySnapshot {
+	endpointResources, endpointTTLs := splitResourcesWithTTL(endpoints)
+	clusterResources, clusterTTLs := splitResourcesWithTTL(clusters)
+	routeResources, routeTTLs := splitResourcesWithTTL(routes)
+	listenerResources, listenerTTLs := splitResourcesWithTTL(listeners)
+
+	snap := NewSnapshot(version, endpointResources, clusterResources, routeResources, listenerResources, nil, nil, nil, nil, opts...)
+	snap.EndpointsTTLs = endpointTTLs
+	snap.ClustersTTLs = clusterTTLs
+	snap.RoutesTTLs = routeTTLs
+	snap.ListenersTTLs = listenerTTLs
+	return snap
 }
 
+// splitResourcesWithTTL separates a list of TTL-annotated resources into the plain resources
+// (for storage alongside the resources that have no TTL) and a map of resource name to TTL,
+// omitting entries for resources with no TTL set.
+func splitResourcesWithTTL(resources []cache.ResourceWithTTL) ([]cache.Resource, map[string]time.Duration) {
+	plain := make([]cache.Resource, 0, len(resources))
+	ttls := make(map[string]time.Duration)
+	for _, r := range resources {
+		plain = append(plain, r.Resource)
+		if r.TTL != nil {
+			ttls[cache.GetResourceName(r.Resource.ResourceProto())] = *r.TTL
+		}
+	}
+	return plain, ttls
+}
+
+// HeartbeatsDue returns the names of the TTL'd resources of type typ that are due for a ttl/2
+// heartbeat DiscoveryResponse as of now: resources with no TTL entry, or whose TTL has not yet
+// reached its halfway point since lastSent, are not due. lastSent holds the time each resource was
+// last sent, keyed by resource name; a resource with no entry in lastSent is always due. Sending
+// the resulting heartbeat responses (and updating lastSent afterwards) is the caller's job.
+func (s *EnvoySnapshot) HeartbeatsDue(typ string, lastSent map[string]time.Time, now time.Time) []string {
+	if s == nil {
+		return nil
+	}
+	_, ttls := s.GetResourcesAndTTL(typ)
+	if len(ttls) == 0 {
+		return nil
+	}
+
+	var due []string
+	for name, ttl := range ttls {
+		last, ok := lastSent[name]
+		if !ok || now.Sub(last) >= ttl/2 {
+			due = append(due, name)
+		}
+	}
+	return due
+}
 
 func downgradeResource(e cache.Resource) *resource.EnvoyResource {
 	var downgradedResource cache.ResourceProto
@@ -105,13 +290,19 @@ func downgradeResource(e cache.Resource) *resource.EnvoyResource {
 	}
 	switch v := res.(type) {
 	case *envoy_config_endpoint_v3.ClusterLoadAssignment:
-		// No downgrade necessary
+		// No downgrade necessary: the V2 and V3 wire formats are identical, so the resource is
+		// passed through unchanged rather than dropped.
+		downgradedResource = v
 	case *envoy_config_cluster_v3.Cluster:
 		downgradedResource = internal.DowngradeCluster(v)
 	case *envoy_config_route_v3.RouteConfiguration:
-		// No downgrade necessary
+		// No downgrade necessary: the V2 and V3 wire formats are identical, so the resource is
+		// passed through unchanged rather than dropped.
+		downgradedResource = v
 	case *envoy_config_listener_v3.Listener:
 		downgradedResource = internal.DowngradeListener(v)
+	case *envoy_extensions_transport_sockets_tls_v3.Secret:
+		downgradedResource = internal.DowngradeSecret(v)
 	}
 	return &resource.EnvoyResource{ProtoMessage: downgradedResource}
 }
@@ -151,11 +342,12 @@ func (s *EnvoySnapshot) Consistent() error {
 	if s == nil {
 		return errors.New("nil snapshot")
 	}
+	endpointResources := s.GetResources(resource.EndpointTypeV3)
 	endpoints := resource.GetResourceReferences(s.Clusters.Items)
-	if len(endpoints) != len(s.Endpoints.Items) {
-		return fmt.Errorf("mismatched endpoint reference and resource lengths: length of %v does not equal length of %v", endpoints, s.Endpoints.Items)
+	if len(endpoints) != len(endpointResources.Items) {
+		return fmt.Errorf("mismatched endpoint reference and resource lengths: length of %v does not equal length of %v", endpoints, endpointResources.Items)
 	}
-	if err := cache.Superset(endpoints, s.Endpoints.Items); err != nil {
+	if err := cache.Superset(endpoints, endpointResources.Items); err != nil {
 		return err
 	}
 
@@ -163,11 +355,126 @@ func (s *EnvoySnapshot) Consistent() error {
 	if len(routes) != len(s.Routes.Items) {
 		return fmt.Errorf("mismatched route reference and resource lengths: length of %v does not equal length of %v", routes, s.Routes.Items)
 	}
-	return cache.Superset(routes, s.Routes.Items)
+	if err := cache.Superset(routes, s.Routes.Items); err != nil {
+		return err
+	}
+
+	for _, scopedRouteRes := range s.ScopedRoutes.Items {
+		scopedRoute, ok := scopedRouteRes.ResourceProto().(*envoy_config_route_v3.ScopedRouteConfiguration)
+		if !ok || scopedRoute.GetRouteConfigurationName() == "" {
+			continue
+		}
+		if _, ok := s.Routes.Items[scopedRoute.GetRouteConfigurationName()]; !ok {
+			return fmt.Errorf("scoped route config %q references missing route configuration %q", scopedRoute.GetName(), scopedRoute.GetRouteConfigurationName())
+		}
+	}
+
+	// A set, not a slice: the same scoped-routes name is commonly referenced by the HTTP connection
+	// manager in more than one filter chain on a listener (or across listeners), and that must not
+	// be mistaken for multiple distinct references against s.ScopedRoutes.Items below.
+	scopedRouteNames := make(map[string]struct{})
+	err := forEachHTTPConnectionManager(s.Listeners.Items, func(listener *envoy_config_listener_v3.Listener, hcm *envoy_hcm_v3.HttpConnectionManager) error {
+		if scopedRoutes := hcm.GetScopedRoutes(); scopedRoutes != nil && scopedRoutes.GetName() != "" {
+			scopedRouteNames[scopedRoutes.GetName()] = struct{}{}
+		}
+		for _, httpFilter := range hcm.GetHttpFilters() {
+			if httpFilter.GetConfigDiscovery() == nil {
+				continue
+			}
+			if _, ok := s.ExtensionConfigs.Items[httpFilter.GetName()]; !ok {
+				return fmt.Errorf("http filter %q on listener %q references missing extension config", httpFilter.GetName(), listener.GetName())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Every scoped-routes config a listener subscribes to over SRDS must correspond to a
+	// ScopedRouteConfiguration actually present in the snapshot, mirroring the CDS/EDS and
+	// LDS/RDS checks above.
+	scopedRouteNameList := make([]string, 0, len(scopedRouteNames))
+	for name := range scopedRouteNames {
+		scopedRouteNameList = append(scopedRouteNameList, name)
+	}
+	if len(scopedRouteNameList) != len(s.ScopedRoutes.Items) {
+		return fmt.Errorf("mismatched scoped route reference and resource lengths: length of %v does not equal length of %v", scopedRouteNameList, s.ScopedRoutes.Items)
+	}
+	return cache.Superset(scopedRouteNameList, s.ScopedRoutes.Items)
 }
 
-// GetResources selects snapshot resources by type.
+// forEachHTTPConnectionManager walks every HTTP connection manager filter configured across all
+// filter chains of the given listeners, invoking fn for each. Filters that aren't configured as
+// an HTTP connection manager (or fail to unmarshal as one) are skipped. Stops and returns the
+// first error fn returns.
+func forEachHTTPConnectionManager(listeners map[string]cache.Resource, fn func(listener *envoy_config_listener_v3.Listener, hcm *envoy_hcm_v3.HttpConnectionManager) error) error {
+	for _, listenerRes := range listeners {
+		listener, ok := listenerRes.ResourceProto().(*envoy_config_listener_v3.Listener)
+		if !ok {
+			continue
+		}
+		for _, filterChain := range listener.GetFilterChains() {
+			for _, filter := range filterChain.GetFilters() {
+				hcm := &envoy_hcm_v3.HttpConnectionManager{}
+				if err := ptypes.UnmarshalAny(filter.GetTypedConfig(), hcm); err != nil {
+					continue
+				}
+				if err := fn(listener, hcm); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// GetResourcesForWatch returns the resources visible to a single xDS watch of type typ,
+// restricted to subscription when it is non-empty. For EndpointTypeV3 backed by a
+// LinearEndpointCache: a SotW watch (incremental false) gets the full current state of every
+// subscribed name (or of every tracked resource, for a wildcard watch), per the SotW rule that
+// non-wildcard watches must always receive complete resource state; an incremental/delta watch
+// (incremental true) gets only the resources that changed since lastVersion. Every other type,
+// and EndpointTypeV3 with no LinearEndpointCache attached, ignores subscription/incremental/
+// lastVersion and falls back to the whole-snapshot resource set.
+func (s *EnvoySnapshot) GetResourcesForWatch(typ string, subscription []string, incremental bool, lastVersion uint64) cache.Resources {
+	if s == nil {
+		return cache.Resources{}
+	}
+	if typ == resource.EndpointTypeV3 && s.endpointCache != nil {
+		if incremental {
+			changed, removed := s.endpointCache.GetDeltaResources(lastVersion, subscription)
+			// NOTE: plain cache.Resources has no field for removed names; callers that need to
+			// unsubscribe watches from deleted endpoints should use GetDeltaResources directly
+			// until the incremental xDS server loop threads removals through its own response type.
+			_ = removed
+			return cache.Resources{
+				Version: fmt.Sprintf("%d", s.endpointCache.Version()),
+				Items:   changed,
+			}
+		}
+		return cache.Resources{
+			Version: fmt.Sprintf("%d", s.endpointCache.Version()),
+			Items:   s.endpointCache.GetSotWResources(subscription),
+		}
+	}
+	if typ == resource.EndpointTypeV3 {
+		return s.Endpoints
+	}
+	return s.getResourcesByType(typ)
+}
+
+// GetResources selects snapshot resources by type. This is the wildcard-SotW special case of
+// GetResourcesForWatch (no subscription, not incremental), expressed separately because it's the
+// read path the rest of this package (e.g. Consistent) uses.
 func (s *EnvoySnapshot) GetResources(typ string) cache.Resources {
+	return s.GetResourcesForWatch(typ, nil, false, 0)
+}
+
+// getResourcesByType is the plain, watch-agnostic resource lookup used as the fallback for every
+// type GetResourcesForWatch doesn't special-case (i.e. everything but EndpointTypeV3, which
+// GetResourcesForWatch answers itself with or without a LinearEndpointCache).
+func (s *EnvoySnapshot) getResourcesByType(typ string) cache.Resources {
 	if s == nil {
 		return cache.Resources{}
 	}
@@ -188,10 +495,41 @@ func (s *EnvoySnapshot) GetResources(typ string) cache.Resources {
 		return s.hiddenDeprecatedRoutes
 	case resource.ListenerTypeV2:
 		return s.hiddenDeprecatedListeners
+	case resource.SecretTypeV3:
+		return s.Secrets
+	case resource.SecretTypeV2:
+		return s.hiddenDeprecatedSecrets
+	case resource.RuntimeTypeV3:
+		return s.Runtimes
+	case resource.ScopedRouteTypeV3:
+		return s.ScopedRoutes
+	case resource.ExtensionConfigTypeV3:
+		return s.ExtensionConfigs
 	}
 	return cache.Resources{}
 }
 
+// GetResourcesAndTTL selects snapshot resources and their optional per-resource TTLs by type.
+// Types that do not support TTLs (e.g. the deprecated V2 payloads and Secrets) always report a nil TTL map.
+func (s *EnvoySnapshot) GetResourcesAndTTL(typ string) (cache.Resources, map[string]time.Duration) {
+	if s == nil {
+		return cache.Resources{}, nil
+	}
+	switch typ {
+	case resource.EndpointTypeV3:
+		// Route through GetResources so this agrees with GetResources/GetResourcesForWatch once a
+		// LinearEndpointCache is attached, instead of reading the (possibly stale) Endpoints field.
+		return s.GetResources(typ), s.EndpointsTTLs
+	case resource.ClusterTypeV3:
+		return s.Clusters, s.ClustersTTLs
+	case resource.RouteTypeV3:
+		return s.Routes, s.RoutesTTLs
+	case resource.ListenerTypeV3:
+		return s.Listeners, s.ListenersTTLs
+	}
+	return s.GetResources(typ), nil
+}
+
 func (s *EnvoySnapshot) Clone() cache.Snapshot {
 	snapshotClone := &EnvoySnapshot{}
 
@@ -235,9 +573,54 @@ func (s *EnvoySnapshot) Clone() cache.Snapshot {
 		Items:   cloneItems(s.hiddenDeprecatedListeners.Items),
 	}
 
+	snapshotClone.Secrets = cache.Resources{
+		Version: s.Secrets.Version,
+		Items:   cloneItems(s.Secrets.Items),
+	}
+
+	snapshotClone.hiddenDeprecatedSecrets = cache.Resources{
+		Version: s.hiddenDeprecatedSecrets.Version,
+		Items:   cloneItems(s.hiddenDeprecatedSecrets.Items),
+	}
+
+	snapshotClone.Runtimes = cache.Resources{
+		Version: s.Runtimes.Version,
+		Items:   cloneItems(s.Runtimes.Items),
+	}
+
+	snapshotClone.ScopedRoutes = cache.Resources{
+		Version: s.ScopedRoutes.Version,
+		Items:   cloneItems(s.ScopedRoutes.Items),
+	}
+
+	snapshotClone.ExtensionConfigs = cache.Resources{
+		Version: s.ExtensionConfigs.Version,
+		Items:   cloneItems(s.ExtensionConfigs.Items),
+	}
+
+	snapshotClone.EndpointsTTLs = cloneTTLs(s.EndpointsTTLs)
+	snapshotClone.ClustersTTLs = cloneTTLs(s.ClustersTTLs)
+	snapshotClone.RoutesTTLs = cloneTTLs(s.RoutesTTLs)
+	snapshotClone.ListenersTTLs = cloneTTLs(s.ListenersTTLs)
+
+	// The linear endpoint cache is a shared, independently-versioned store, not per-snapshot
+	// state, so it is carried over by reference rather than deep-copied.
+	snapshotClone.endpointCache = s.endpointCache
+
 	return snapshotClone
 }
 
+func cloneTTLs(ttls map[string]time.Duration) map[string]time.Duration {
+	if ttls == nil {
+		return nil
+	}
+	cloned := make(map[string]time.Duration, len(ttls))
+	for k, v := range ttls {
+		cloned[k] = v
+	}
+	return cloned
+}
+
 func cloneItems(items map[string]cache.Resource) map[string]cache.Resource {
 	clonedItems := make(map[string]cache.Resource, len(items))
 	for k, v := range items {
@@ -0,0 +1,54 @@
+package xds
+
+import (
+	"fmt"
+	"testing"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+const benchResourceCount = 5000
+
+func benchClusters(n int) []cache.Resource {
+	clusters := make([]cache.Resource, n)
+	for i := 0; i < n; i++ {
+		clusters[i] = resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{
+			Name: fmt.Sprintf("cluster-%d", i),
+		})
+	}
+	return clusters
+}
+
+func benchListeners(n int) []cache.Resource {
+	listeners := make([]cache.Resource, n)
+	for i := 0; i < n; i++ {
+		listeners[i] = resource.NewEnvoyResource(&envoy_config_listener_v3.Listener{
+			Name: fmt.Sprintf("listener-%d", i),
+		})
+	}
+	return listeners
+}
+
+// BenchmarkNewSnapshot_V2Downgrade measures the cost of building a snapshot with 5k clusters and
+// listeners, with and without the V2 downgrade path.
+func BenchmarkNewSnapshot_V2Downgrade(b *testing.B) {
+	clusters := benchClusters(benchResourceCount)
+	listeners := benchListeners(benchResourceCount)
+
+	b.Run("with downgrade", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			NewSnapshot("v1", nil, clusters, nil, listeners, nil, nil, nil, nil)
+		}
+	})
+
+	b.Run("without downgrade", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			NewSnapshot("v1", nil, clusters, nil, listeners, nil, nil, nil, nil, WithoutV2Downgrade())
+		}
+	})
+}
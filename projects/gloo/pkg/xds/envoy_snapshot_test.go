@@ -0,0 +1,226 @@
+package xds
+
+import (
+	"testing"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_hcm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_service_runtime_v3 "github.com/envoyproxy/go-control-plane/envoy/service/runtime/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+func secretRes(name string) cache.Resource {
+	return resource.NewEnvoyResource(&envoy_extensions_transport_sockets_tls_v3.Secret{Name: name})
+}
+
+func runtimeRes(name string) cache.Resource {
+	return resource.NewEnvoyResource(&envoy_service_runtime_v3.Runtime{Name: name})
+}
+
+func scopedRouteRes(name, routeConfigName string) cache.Resource {
+	return resource.NewEnvoyResource(&envoy_config_route_v3.ScopedRouteConfiguration{
+		Name:                   name,
+		RouteConfigurationName: routeConfigName,
+	})
+}
+
+func extensionConfigRes(name string) cache.Resource {
+	return resource.NewEnvoyResource(&envoy_config_core_v3.TypedExtensionConfig{Name: name})
+}
+
+func TestNewSnapshot_SecretsRuntimesScopedRoutesExtensionConfigs(t *testing.T) {
+	snap := NewSnapshot("v1",
+		nil,                                      // endpoints
+		nil,                                       // clusters
+		nil,                                       // routes
+		nil,                                       // listeners
+		[]cache.Resource{secretRes("secret1")},    // secrets
+		[]cache.Resource{runtimeRes("runtime1")},  // runtimes
+		[]cache.Resource{scopedRouteRes("sr1", "route1")}, // scopedRoutes
+		[]cache.Resource{extensionConfigRes("ec1")},       // extensionConfigs
+	)
+
+	if _, ok := snap.GetResources(resource.SecretTypeV3).Items["secret1"]; !ok {
+		t.Fatalf("expected secret %q in SDS resources", "secret1")
+	}
+	if _, ok := snap.GetResources(resource.RuntimeTypeV3).Items["runtime1"]; !ok {
+		t.Fatalf("expected runtime %q in RTDS resources", "runtime1")
+	}
+	if _, ok := snap.GetResources(resource.ScopedRouteTypeV3).Items["sr1"]; !ok {
+		t.Fatalf("expected scoped route %q in SRDS resources", "sr1")
+	}
+	if _, ok := snap.GetResources(resource.ExtensionConfigTypeV3).Items["ec1"]; !ok {
+		t.Fatalf("expected extension config %q in ECDS resources", "ec1")
+	}
+
+	cloned, ok := snap.Clone().(*EnvoySnapshot)
+	if !ok {
+		t.Fatalf("Clone() did not return *EnvoySnapshot")
+	}
+	if _, ok := cloned.GetResources(resource.SecretTypeV3).Items["secret1"]; !ok {
+		t.Fatalf("expected secret %q to survive Clone()", "secret1")
+	}
+	if _, ok := cloned.GetResources(resource.RuntimeTypeV3).Items["runtime1"]; !ok {
+		t.Fatalf("expected runtime %q to survive Clone()", "runtime1")
+	}
+	if _, ok := cloned.GetResources(resource.ScopedRouteTypeV3).Items["sr1"]; !ok {
+		t.Fatalf("expected scoped route %q to survive Clone()", "sr1")
+	}
+	if _, ok := cloned.GetResources(resource.ExtensionConfigTypeV3).Items["ec1"]; !ok {
+		t.Fatalf("expected extension config %q to survive Clone()", "ec1")
+	}
+}
+
+func clusterWithTTL(name string, ttl *time.Duration) cache.ResourceWithTTL {
+	return cache.ResourceWithTTL{
+		Resource: resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{Name: name}),
+		TTL:      ttl,
+	}
+}
+
+func TestSplitResourcesWithTTL(t *testing.T) {
+	ttl := 10 * time.Second
+	plain, ttls := splitResourcesWithTTL([]cache.ResourceWithTTL{
+		clusterWithTTL("with-ttl", &ttl),
+		clusterWithTTL("without-ttl", nil),
+	})
+
+	if len(plain) != 2 {
+		t.Fatalf("expected both resources in the plain list regardless of TTL, got %d", len(plain))
+	}
+	if got, want := ttls["with-ttl"], ttl; got != want {
+		t.Fatalf("ttls[%q] = %v, want %v", "with-ttl", got, want)
+	}
+	if _, ok := ttls["without-ttl"]; ok {
+		t.Fatalf("resource with no TTL set should not have an entry in the TTL map")
+	}
+}
+
+func TestHeartbeatsDue(t *testing.T) {
+	ttl := 10 * time.Second
+	snap := NewSnapshotWithTTLs("v1",
+		nil,
+		[]cache.ResourceWithTTL{clusterWithTTL("c1", &ttl)},
+		nil,
+		nil,
+	)
+
+	now := time.Now()
+
+	if due := snap.HeartbeatsDue(resource.ClusterTypeV3, nil, now); len(due) != 1 || due[0] != "c1" {
+		t.Fatalf("a resource never sent should always be due, got %v", due)
+	}
+
+	recentlySent := map[string]time.Time{"c1": now.Add(-1 * time.Second)}
+	if due := snap.HeartbeatsDue(resource.ClusterTypeV3, recentlySent, now); len(due) != 0 {
+		t.Fatalf("a resource sent well within ttl/2 should not be due, got %v", due)
+	}
+
+	staleSent := map[string]time.Time{"c1": now.Add(-6 * time.Second)}
+	if due := snap.HeartbeatsDue(resource.ClusterTypeV3, staleSent, now); len(due) != 1 || due[0] != "c1" {
+		t.Fatalf("a resource last sent more than ttl/2 ago should be due, got %v", due)
+	}
+
+	if due := snap.HeartbeatsDue(resource.RouteTypeV3, nil, now); len(due) != 0 {
+		t.Fatalf("a type with no TTL'd resources should never report anything due, got %v", due)
+	}
+}
+
+// hcmFilter builds a network filter configured as an HttpConnectionManager. When scopedRoutesName
+// is non-empty, the HCM is configured to use SRDS with that scoped-routes name. When
+// ecdsFilterName is non-empty, the HCM carries one HTTP filter configured via ECDS with that name.
+func hcmFilter(t *testing.T, scopedRoutesName, ecdsFilterName string) *envoy_config_listener_v3.Filter {
+	t.Helper()
+
+	hcm := &envoy_hcm_v3.HttpConnectionManager{}
+	if scopedRoutesName != "" {
+		hcm.RouteSpecifier = &envoy_hcm_v3.HttpConnectionManager_ScopedRoutes{
+			ScopedRoutes: &envoy_hcm_v3.ScopedRoutes{Name: scopedRoutesName},
+		}
+	}
+	if ecdsFilterName != "" {
+		hcm.HttpFilters = []*envoy_hcm_v3.HttpFilter{{
+			Name: ecdsFilterName,
+			ConfigType: &envoy_hcm_v3.HttpFilter_ConfigDiscovery{
+				ConfigDiscovery: &envoy_config_core_v3.ExtensionConfigSource{},
+			},
+		}}
+	}
+
+	any, err := ptypes.MarshalAny(hcm)
+	if err != nil {
+		t.Fatalf("failed to marshal HttpConnectionManager: %v", err)
+	}
+	return &envoy_config_listener_v3.Filter{
+		Name:       "envoy.filters.network.http_connection_manager",
+		ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: any},
+	}
+}
+
+// listenerWithHCMFilterChains builds a listener with filterChainCount filter chains, each
+// containing a single HTTP connection manager filter identically configured per hcmFilter.
+func listenerWithHCMFilterChains(t *testing.T, name string, filterChainCount int, scopedRoutesName, ecdsFilterName string) cache.Resource {
+	t.Helper()
+
+	listener := &envoy_config_listener_v3.Listener{Name: name}
+	for i := 0; i < filterChainCount; i++ {
+		listener.FilterChains = append(listener.FilterChains, &envoy_config_listener_v3.FilterChain{
+			Filters: []*envoy_config_listener_v3.Filter{hcmFilter(t, scopedRoutesName, ecdsFilterName)},
+		})
+	}
+	return resource.NewEnvoyResource(listener)
+}
+
+func TestConsistent_ScopedRoutesDedupedAcrossFilterChains(t *testing.T) {
+	// Regression test: a listener whose HTTP connection manager is repeated across several filter
+	// chains but always names the same scoped-routes group must not be treated as multiple
+	// distinct SRDS references.
+	listener := listenerWithHCMFilterChains(t, "listener1", 3, "sr-group", "")
+	snap := NewSnapshot("v1", nil, nil,
+		[]cache.Resource{resource.NewEnvoyResource(&envoy_config_route_v3.RouteConfiguration{Name: "route1"})},
+		[]cache.Resource{listener},
+		nil, nil,
+		[]cache.Resource{scopedRouteRes("sr-group", "route1")},
+		nil,
+	)
+
+	if err := snap.Consistent(); err != nil {
+		t.Fatalf("Consistent() should not fail when multiple filter chains share one scoped-routes name: %v", err)
+	}
+}
+
+func TestConsistent_MissingScopedRouteConfiguration(t *testing.T) {
+	listener := listenerWithHCMFilterChains(t, "listener1", 1, "sr-missing", "")
+	snap := NewSnapshot("v1", nil, nil, nil, []cache.Resource{listener}, nil, nil, nil, nil)
+
+	if err := snap.Consistent(); err == nil {
+		t.Fatalf("Consistent() should fail when a listener references a scoped-routes config absent from the snapshot")
+	}
+}
+
+func TestConsistent_MissingExtensionConfig(t *testing.T) {
+	listener := listenerWithHCMFilterChains(t, "listener1", 1, "", "ecds-missing")
+	snap := NewSnapshot("v1", nil, nil, nil, []cache.Resource{listener}, nil, nil, nil, nil)
+
+	if err := snap.Consistent(); err == nil {
+		t.Fatalf("Consistent() should fail when an http filter references an extension config absent from the snapshot")
+	}
+}
+
+func TestConsistent_ExtensionConfigPresent(t *testing.T) {
+	listener := listenerWithHCMFilterChains(t, "listener1", 1, "", "ecds1")
+	snap := NewSnapshot("v1", nil, nil, nil, []cache.Resource{listener}, nil, nil, nil,
+		[]cache.Resource{extensionConfigRes("ecds1")},
+	)
+
+	if err := snap.Consistent(); err != nil {
+		t.Fatalf("Consistent() should not fail when the referenced extension config is present: %v", err)
+	}
+}
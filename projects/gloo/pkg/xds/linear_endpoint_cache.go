@@ -0,0 +1,191 @@
+package xds
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+// LinearEndpointCache is a delta-aware cache of EDS resources, modeled on go-control-plane's
+// linear cache. Unlike the rest of EnvoySnapshot, which bumps a single version for the whole
+// snapshot on every Gloo translation cycle, it tracks a version per resource so that only the
+// endpoints that actually changed need to be re-sent to watches. This matters for large
+// endpoint sets that otherwise force a full EDS push on every pod churn.
+type LinearEndpointCache struct {
+	mu sync.RWMutex
+
+	// resources holds the current value of every tracked resource, keyed by name.
+	resources map[string]cache.Resource
+
+	// versionVector holds the version at which each resource was last changed.
+	versionVector map[string]uint64
+
+	// tombstones holds the version at which each no-longer-tracked resource was removed, so that a
+	// delta watch that last saw an earlier version can learn the name was deleted rather than
+	// simply seeing nothing change. Entries are cleared if the name is added back.
+	tombstones map[string]uint64
+
+	// version is the version that was assigned to the most recent resource mutation.
+	version uint64
+}
+
+// NewLinearEndpointCache creates an empty LinearEndpointCache.
+func NewLinearEndpointCache() *LinearEndpointCache {
+	return &LinearEndpointCache{
+		resources:     make(map[string]cache.Resource),
+		versionVector: make(map[string]uint64),
+		tombstones:    make(map[string]uint64),
+	}
+}
+
+// UpdateResource sets or replaces the resource with the given name. The resource's version is
+// only bumped if the new value differs from what is already stored.
+func (c *LinearEndpointCache) UpdateResource(name string, r cache.Resource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if existing, ok := c.resources[name]; ok && resourcesEqual(existing, r) {
+		return
+	}
+
+	c.version++
+	c.resources[name] = r
+	c.versionVector[name] = c.version
+	delete(c.tombstones, name)
+}
+
+// DeleteResource removes the resource with the given name, if present, recording a tombstone so
+// that a delta watch which last saw an earlier version can learn the name was removed.
+func (c *LinearEndpointCache) DeleteResource(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.resources[name]; !ok {
+		return
+	}
+
+	c.version++
+	delete(c.resources, name)
+	delete(c.versionVector, name)
+	c.tombstones[name] = c.version
+}
+
+// SetResources replaces the full set of tracked resources. Only resources that are new or whose
+// contents changed get their version bumped; resources no longer present are dropped and recorded
+// as tombstones, each at the version it was dropped, without bumping the version of anything else.
+func (c *LinearEndpointCache) SetResources(resources map[string]cache.Resource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, r := range resources {
+		if existing, ok := c.resources[name]; ok && resourcesEqual(existing, r) {
+			continue
+		}
+		c.version++
+		c.resources[name] = r
+		c.versionVector[name] = c.version
+		delete(c.tombstones, name)
+	}
+
+	for name := range c.resources {
+		if _, ok := resources[name]; !ok {
+			c.version++
+			delete(c.resources, name)
+			delete(c.versionVector, name)
+			c.tombstones[name] = c.version
+		}
+	}
+}
+
+// Version returns the cache's current global version, which increases by at least one on every
+// mutation that changes a resource.
+func (c *LinearEndpointCache) Version() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.version
+}
+
+// GetSotWResources returns the resources a state-of-the-world EDS watch should see. When
+// subscription is empty (a wildcard watch), every tracked resource is returned. When subscription
+// is non-empty, the full current state of each subscribed resource is returned regardless of
+// whether it changed recently: per the SotW protocol, a non-wildcard watch must always receive
+// the complete state of the resources it asked for, not just the delta.
+func (c *LinearEndpointCache) GetSotWResources(subscription []string) map[string]cache.Resource {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if len(subscription) == 0 {
+		out := make(map[string]cache.Resource, len(c.resources))
+		for name, r := range c.resources {
+			out[name] = r
+		}
+		return out
+	}
+
+	out := make(map[string]cache.Resource, len(subscription))
+	for _, name := range subscription {
+		if r, ok := c.resources[name]; ok {
+			out[name] = r
+		}
+	}
+	return out
+}
+
+// GetDeltaResources returns the resources that changed after lastVersion, restricted to
+// subscription when it is non-empty, along with the names of any subscribed (or, for a wildcard
+// watch, any tombstoned) resources that were removed after lastVersion. This is the
+// incremental-xDS (delta) read path: a client that has already acked lastVersion needs to learn
+// both what changed and what was deleted since then, since a delta response's resource_names_unsubscribe
+// (or equivalent removal signal) cannot be inferred merely from a name's absence in the changed set.
+func (c *LinearEndpointCache) GetDeltaResources(lastVersion uint64, subscription []string) (changed map[string]cache.Resource, removed []string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	changed = make(map[string]cache.Resource)
+
+	if len(subscription) > 0 {
+		for _, name := range subscription {
+			if tombstoneVersion, ok := c.tombstones[name]; ok && tombstoneVersion > lastVersion {
+				removed = append(removed, name)
+				continue
+			}
+			r, ok := c.resources[name]
+			if !ok {
+				continue
+			}
+			if c.versionVector[name] > lastVersion {
+				changed[name] = r
+			}
+		}
+		return changed, removed
+	}
+
+	for name, r := range c.resources {
+		if c.versionVector[name] > lastVersion {
+			changed[name] = r
+		}
+	}
+	for name, tombstoneVersion := range c.tombstones {
+		if tombstoneVersion > lastVersion {
+			removed = append(removed, name)
+		}
+	}
+	return changed, removed
+}
+
+// Snapshot materializes the cache's current wildcard SotW state as cache.Resources, stamped with
+// the cache's own version rather than the enclosing EnvoySnapshot's version, so callers can tell
+// whether the endpoint set actually changed. This is the same view that
+// EnvoySnapshot.GetResources(EndpointTypeV3) returns when this cache is attached.
+func (c *LinearEndpointCache) Snapshot() cache.Resources {
+	return cache.Resources{
+		Version: fmt.Sprintf("%d", c.Version()),
+		Items:   c.GetSotWResources(nil),
+	}
+}
+
+func resourcesEqual(a, b cache.Resource) bool {
+	return proto.Equal(a.ResourceProto(), b.ResourceProto())
+}
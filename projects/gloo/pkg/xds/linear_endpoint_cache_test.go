@@ -0,0 +1,206 @@
+package xds
+
+import (
+	"testing"
+
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+func cla(clusterName string) cache.Resource {
+	return resource.NewEnvoyResource(&envoy_config_endpoint_v3.ClusterLoadAssignment{
+		ClusterName: clusterName,
+	})
+}
+
+func TestLinearEndpointCache_UpdateResource(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(c *LinearEndpointCache)
+		wantVersion uint64
+	}{
+		{
+			name:        "first update bumps version",
+			setup:       func(c *LinearEndpointCache) { c.UpdateResource("a", cla("a")) },
+			wantVersion: 1,
+		},
+		{
+			name: "re-applying the same value does not bump version",
+			setup: func(c *LinearEndpointCache) {
+				c.UpdateResource("a", cla("a"))
+				c.UpdateResource("a", cla("a"))
+			},
+			wantVersion: 1,
+		},
+		{
+			name: "applying a changed value bumps version again",
+			setup: func(c *LinearEndpointCache) {
+				c.UpdateResource("a", cla("a"))
+				c.UpdateResource("a", cla("a-changed"))
+			},
+			wantVersion: 2,
+		},
+		{
+			name: "updating a different resource bumps version independently",
+			setup: func(c *LinearEndpointCache) {
+				c.UpdateResource("a", cla("a"))
+				c.UpdateResource("b", cla("b"))
+			},
+			wantVersion: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewLinearEndpointCache()
+			tc.setup(c)
+			if got := c.Version(); got != tc.wantVersion {
+				t.Errorf("Version() = %d, want %d", got, tc.wantVersion)
+			}
+		})
+	}
+}
+
+func TestLinearEndpointCache_DeleteResource(t *testing.T) {
+	c := NewLinearEndpointCache()
+	c.UpdateResource("a", cla("a"))
+
+	c.DeleteResource("does-not-exist")
+	if got, want := c.Version(), uint64(1); got != want {
+		t.Fatalf("deleting a missing resource should not bump version: got %d, want %d", got, want)
+	}
+
+	c.DeleteResource("a")
+	if got, want := c.Version(), uint64(2); got != want {
+		t.Fatalf("deleting a present resource should bump version: got %d, want %d", got, want)
+	}
+	if _, ok := c.GetSotWResources(nil)["a"]; ok {
+		t.Fatalf("deleted resource %q should not appear in SotW reads", "a")
+	}
+}
+
+func TestLinearEndpointCache_SetResources(t *testing.T) {
+	c := NewLinearEndpointCache()
+	c.SetResources(map[string]cache.Resource{
+		"a": cla("a"),
+		"b": cla("b"),
+	})
+	if got, want := c.Version(), uint64(2); got != want {
+		t.Fatalf("initial SetResources should bump version once per resource: got %d, want %d", got, want)
+	}
+
+	// Dropping "b" bumps the version (so its removal can be reported as a tombstone) without
+	// touching "a"'s version.
+	c.SetResources(map[string]cache.Resource{
+		"a": cla("a"),
+	})
+	if got, want := c.Version(), uint64(3); got != want {
+		t.Fatalf("SetResources dropping a resource should bump version once: got %d, want %d", got, want)
+	}
+	if _, ok := c.GetSotWResources(nil)["b"]; ok {
+		t.Fatalf("resource %q dropped from SetResources should no longer be tracked", "b")
+	}
+
+	// Re-applying the same set again should not bump the version further.
+	c.SetResources(map[string]cache.Resource{
+		"a": cla("a"),
+	})
+	if got, want := c.Version(), uint64(3); got != want {
+		t.Fatalf("SetResources with no real changes should not bump version: got %d, want %d", got, want)
+	}
+}
+
+func TestLinearEndpointCache_GetSotWResources(t *testing.T) {
+	c := NewLinearEndpointCache()
+	c.UpdateResource("a", cla("a"))
+	c.UpdateResource("b", cla("b"))
+
+	wildcard := c.GetSotWResources(nil)
+	if len(wildcard) != 2 {
+		t.Fatalf("wildcard SotW watch should see every tracked resource, got %d", len(wildcard))
+	}
+
+	// A non-wildcard SotW watch must see the full state of every subscribed resource, even
+	// resources whose version predates the watch.
+	subscribed := c.GetSotWResources([]string{"a"})
+	if len(subscribed) != 1 {
+		t.Fatalf("non-wildcard SotW watch should only see subscribed resources, got %d", len(subscribed))
+	}
+	if _, ok := subscribed["a"]; !ok {
+		t.Fatalf("subscribed resource %q missing from SotW read", "a")
+	}
+}
+
+func TestLinearEndpointCache_GetDeltaResources(t *testing.T) {
+	c := NewLinearEndpointCache()
+	c.UpdateResource("a", cla("a")) // version 1
+	afterA := c.Version()
+	c.UpdateResource("b", cla("b")) // version 2
+
+	delta, removed := c.GetDeltaResources(afterA, nil)
+	if len(delta) != 1 {
+		t.Fatalf("delta watch should only see resources changed after lastVersion, got %d", len(delta))
+	}
+	if _, ok := delta["b"]; !ok {
+		t.Fatalf("expected changed resource %q in delta read", "b")
+	}
+	if len(removed) != 0 {
+		t.Fatalf("delta watch should not report removals when nothing was deleted, got %v", removed)
+	}
+
+	noChanges, _ := c.GetDeltaResources(c.Version(), nil)
+	if len(noChanges) != 0 {
+		t.Fatalf("delta watch at the current version should see no changes, got %d", len(noChanges))
+	}
+
+	restricted, _ := c.GetDeltaResources(0, []string{"a"})
+	if len(restricted) != 1 {
+		t.Fatalf("delta watch restricted to a subscription should ignore unsubscribed changes, got %d", len(restricted))
+	}
+	if _, ok := restricted["a"]; !ok {
+		t.Fatalf("expected subscribed resource %q in restricted delta read", "a")
+	}
+}
+
+func TestLinearEndpointCache_GetDeltaResources_Removals(t *testing.T) {
+	c := NewLinearEndpointCache()
+	c.UpdateResource("a", cla("a")) // version 1
+	c.UpdateResource("b", cla("b")) // version 2
+	beforeDelete := c.Version()
+	c.DeleteResource("a") // version 3
+
+	changed, removed := c.GetDeltaResources(beforeDelete, nil)
+	if len(changed) != 0 {
+		t.Fatalf("wildcard delta watch should see no changed resources after a plain delete, got %v", changed)
+	}
+	if len(removed) != 1 || removed[0] != "a" {
+		t.Fatalf("wildcard delta watch should report deleted resource %q as removed, got %v", "a", removed)
+	}
+
+	// A watch subscribed to the deleted name should also learn it was removed.
+	_, subscribedRemoved := c.GetDeltaResources(beforeDelete, []string{"a", "b"})
+	if len(subscribedRemoved) != 1 || subscribedRemoved[0] != "a" {
+		t.Fatalf("subscribed delta watch should report deleted resource %q as removed, got %v", "a", subscribedRemoved)
+	}
+
+	// A watch that already knows about the deletion (lastVersion at or after the tombstone) should
+	// not see it reported again.
+	_, staleRemoved := c.GetDeltaResources(c.Version(), nil)
+	if len(staleRemoved) != 0 {
+		t.Fatalf("delta watch at the current version should not re-report an already-known removal, got %v", staleRemoved)
+	}
+
+	// Re-adding a previously deleted resource should clear its tombstone so it's reported as
+	// changed, not removed.
+	c.UpdateResource("a", cla("a-again")) // version 4
+	changedAgain, removedAgain := c.GetDeltaResources(beforeDelete, nil)
+	if _, ok := changedAgain["a"]; !ok {
+		t.Fatalf("re-added resource %q should appear as changed, got changed=%v removed=%v", "a", changedAgain, removedAgain)
+	}
+	for _, name := range removedAgain {
+		if name == "a" {
+			t.Fatalf("re-added resource %q should not still be reported as removed", "a")
+		}
+	}
+}